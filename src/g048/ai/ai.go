@@ -0,0 +1,362 @@
+/*
+ * File:        ai.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: Expectimax-based solver for G048. Searches the board's
+ *              hypothetical future states and picks the move that leads to
+ *              the best heuristic outcome on average.
+ */
+package ai
+
+import (
+	"../model"
+	"../view"
+	"math"
+	"sync"
+)
+
+/***** Constants *****/
+
+const (
+	// maxSearchDepth caps the number of player moves looked ahead.
+	maxSearchDepth = 4
+	// minBranchProbability prunes chance branches once they become too
+	// unlikely to matter, letting depth grow automatically when few empty
+	// cells remain to branch over.
+	minBranchProbability = 1e-3
+	// twoSpawnChance is the probability the game spawns a 2 (vs. a 4) on an
+	// empty cell.
+	twoSpawnChance = 0.9
+)
+
+// allActions enumerates the moves the search considers at every player node.
+var allActions = [...]model.Action{
+	model.ActionLeft, model.ActionRight, model.ActionUp, model.ActionDown,
+}
+
+// weights tunes the relative importance of each heuristic in evaluate().
+type weights struct {
+	emptyCells   float64
+	monotonicity float64
+	smoothness   float64
+	corner       float64
+}
+
+// defaultWeights were tuned by hand against a handful of played-out games;
+// empty cells and the corner bias dominate, since keeping the board open and
+// the largest tile anchored matters more than local smoothness.
+var defaultWeights = weights{
+	emptyCells:   2.7,
+	monotonicity: 1.0,
+	smoothness:   0.1,
+	corner:       1.0,
+}
+
+// cornerWeightsCache memoizes the "snake" weight matrix per board size, so
+// repeated searches on the same board size don't recompute it on every leaf.
+// cornerWeightsMu guards it, since concurrent tournament-style BestMove calls
+// (chunk0-6's seedable boards are meant to enable exactly that) would
+// otherwise read/write the map unsynchronized.
+var (
+	cornerWeightsCache = map[int][][]float64{}
+	cornerWeightsMu    sync.Mutex
+)
+
+/*
+ cornerWeightsFor builds (or fetches a cached) "snake" matrix that biases the
+ largest tiles towards the top-left corner and descends away from it, so the
+ monotonicity heuristic and the corner bias reinforce the same layout.
+
+ @param size Board size to build the matrix for.
+
+ @return size x size weight matrix.
+*/
+func cornerWeightsFor(size int) [][]float64 {
+	cornerWeightsMu.Lock()
+	defer cornerWeightsMu.Unlock()
+
+	if cached, ok := cornerWeightsCache[size]; ok {
+		return cached
+	}
+	weights := make([][]float64, size)
+	for row := range weights {
+		weights[row] = make([]float64, size)
+	}
+	exponent := size*size - 1
+	for row := 0; row < size; row++ {
+		for i := 0; i < size; i++ {
+			col := i
+			if row%2 == 1 {
+				// Snake back across odd rows so weight descends continuously.
+				col = size - 1 - i
+			}
+			weights[row][col] = math.Pow(4, float64(exponent))
+			exponent--
+		}
+	}
+	cornerWeightsCache[size] = weights
+	return weights
+}
+
+/***** Internal Functions *****/
+
+/*
+ Converts a board-level Action into the view-level Action the rest of the
+ game drives on.
+
+ @param action Board-level action to convert.
+
+ @return Equivalent view-level action.
+*/
+func toViewAction(action model.Action) view.Action {
+	switch action {
+	case model.ActionLeft:
+		return view.ActionLeft
+	case model.ActionRight:
+		return view.ActionRight
+	case model.ActionUp:
+		return view.ActionUp
+	default: // model.ActionDown
+		return view.ActionDown
+	}
+}
+
+/*
+ log2 is a zero-safe log2, since empty tiles (0) have no well-defined log.
+
+ @param tile Tile value to take the log of.
+
+ @return log2(tile), or 0 if tile is empty.
+*/
+func log2(tile model.Tile) float64 {
+	if tile == 0 {
+		return 0
+	}
+	return math.Log2(float64(tile))
+}
+
+/*
+ cloneRows deep-copies a grid so a hypothetical spawn can be written into it
+ without mutating the caller's grid, which model.Grid's shared row slices
+ would otherwise allow.
+
+ @param grid Grid to copy.
+
+ @return Independent copy of grid.
+*/
+func cloneRows(grid model.Grid) model.Grid {
+	clone := make(model.Grid, len(grid))
+	for row := range grid {
+		clone[row] = append([]model.Tile(nil), grid[row]...)
+	}
+	return clone
+}
+
+/*
+ emptyCoordinates collects every empty cell in the grid.
+
+ @param grid Grid to scan.
+
+ @return Coordinates of every empty cell, in row-major order.
+*/
+func emptyCoordinates(grid model.Grid) []model.Coordinate {
+	var empties []model.Coordinate
+	for row := range grid {
+		for col := range grid[row] {
+			if grid[row][col] == 0 {
+				empties = append(empties, model.Coordinate{Row: row, Col: col})
+			}
+		}
+	}
+	return empties
+}
+
+/*
+ monotonicity sums the decreasing runs of log2(tile) along every row and
+ column, rewarding boards whose values fall off smoothly away from the
+ corner that cornerWeights biases towards.
+
+ @param grid Grid to score.
+
+ @return Monotonicity score (higher is more monotonic).
+*/
+func monotonicity(grid model.Grid) float64 {
+	size := len(grid)
+	var total float64
+	for row := 0; row < size; row++ {
+		for col := 1; col < size; col++ {
+			prev, cur := log2(grid[row][col-1]), log2(grid[row][col])
+			if cur <= prev {
+				total += prev - cur
+			}
+		}
+	}
+	for col := 0; col < size; col++ {
+		for row := 1; row < size; row++ {
+			prev, cur := log2(grid[row-1][col]), log2(grid[row][col])
+			if cur <= prev {
+				total += prev - cur
+			}
+		}
+	}
+	return total
+}
+
+/*
+ smoothness penalizes large jumps in value between adjacent, non-empty
+ tiles: the smaller the gaps, the easier the board is to keep merging.
+
+ @param grid Grid to score.
+
+ @return Negative sum of |log2(a)-log2(b)| over adjacent nonzero pairs.
+*/
+func smoothness(grid model.Grid) float64 {
+	size := len(grid)
+	var total float64
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			if grid[row][col] == 0 {
+				continue
+			}
+			value := log2(grid[row][col])
+			if (col+1 < size) && (grid[row][col+1] != 0) {
+				total -= math.Abs(value - log2(grid[row][col+1]))
+			}
+			if (row+1 < size) && (grid[row+1][col] != 0) {
+				total -= math.Abs(value - log2(grid[row+1][col]))
+			}
+		}
+	}
+	return total
+}
+
+/*
+ evaluate scores a leaf grid as a weighted sum of heuristics.
+
+ @param grid Grid to score.
+
+ @return Heuristic score; higher is better for the player.
+*/
+func evaluate(grid model.Grid) float64 {
+	size := len(grid)
+	empties := float64(len(emptyCoordinates(grid)))
+	weights := cornerWeightsFor(size)
+	var corner float64
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			corner += weights[row][col] * float64(grid[row][col])
+		}
+	}
+	return defaultWeights.emptyCells*empties +
+		defaultWeights.monotonicity*monotonicity(grid) +
+		defaultWeights.smoothness*smoothness(grid) +
+		defaultWeights.corner*corner
+}
+
+/*
+ maxNode is the player half of the search: it tries every move and takes the
+ one leading to the highest expected score.
+
+ @param grid        Grid to search from.
+ @param depth       Remaining player moves to search.
+ @param probability Cumulative probability of reaching this node, used to
+                    prune unlikely chance branches below it.
+
+ @return Expected heuristic score of the best move from this grid.
+*/
+func maxNode(grid model.Grid, depth int, probability float64) float64 {
+	if depth <= 0 {
+		return evaluate(grid)
+	}
+	best := math.Inf(-1)
+	movable := false
+	for _, action := range allActions {
+		next, _, changed := grid.Simulate(action)
+		if !changed {
+			continue
+		}
+		movable = true
+		if score := chanceNode(next, depth, probability); score > best {
+			best = score
+		}
+	}
+	if !movable {
+		return evaluate(grid)
+	}
+	return best
+}
+
+/*
+ chanceNode is the board half of the search: it averages over every empty
+ cell spawning a 2 (90%) or a 4 (10%), pruning once the cumulative
+ probability of the branch drops below minBranchProbability.
+
+ @param grid        Grid to search from.
+ @param depth       Remaining player moves to search.
+ @param probability Cumulative probability of reaching this node.
+
+ @return Expected heuristic score averaged over all possible spawns.
+*/
+func chanceNode(grid model.Grid, depth int, probability float64) float64 {
+	if probability < minBranchProbability {
+		return evaluate(grid)
+	}
+	empties := emptyCoordinates(grid)
+	if len(empties) == 0 {
+		return evaluate(grid)
+	}
+	cellProbability := probability / float64(len(empties))
+
+	var total float64
+	for _, pos := range empties {
+		// grid's rows are shared slices, so each spawn is simulated against
+		// its own clone rather than mutating grid in place, or a later
+		// iteration would search a board polluted by an earlier cell's spawn.
+		withTwo := cloneRows(grid)
+		withTwo[pos.Row][pos.Col] = 2
+		total += twoSpawnChance * maxNode(withTwo, depth-1, cellProbability*twoSpawnChance)
+
+		withFour := cloneRows(grid)
+		withFour[pos.Row][pos.Col] = 4
+		total += (1 - twoSpawnChance) * maxNode(withFour, depth-1, cellProbability*(1-twoSpawnChance))
+	}
+	return total / float64(len(empties))
+}
+
+/***** Functions *****/
+
+/*
+ BestMove runs an expectimax search from the board's current state and
+ returns the move it expects to lead to the best outcome.
+
+ @param board Board to search from. Only read via Board.Snapshot(); the
+             live board and its RNG are never touched.
+
+ @return Best move found, or view.ActionIllegal if no move changes the
+         board (i.e. the game is over).
+*/
+func BestMove(board *model.Board) view.Action {
+	grid := board.Snapshot()
+
+	bestScore := math.Inf(-1)
+	bestAction := model.ActionLeft
+	found := false
+	for _, action := range allActions {
+		next, _, changed := grid.Simulate(action)
+		if !changed {
+			continue
+		}
+		score := chanceNode(next, maxSearchDepth-1, 1.0)
+		if !found || score > bestScore {
+			found = true
+			bestScore = score
+			bestAction = action
+		}
+	}
+
+	if !found {
+		return view.ActionIllegal
+	}
+	return toViewAction(bestAction)
+}
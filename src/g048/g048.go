@@ -8,53 +8,171 @@
 package main
 
 import (
+	"./ai"
 	"./model"
 	"./view"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 )
 
 /***** Constants *****/
 
 // USAGE message to display on bad input
-const USAGE string = "Usage: g048 [help]"
+const USAGE string = "Usage: g048 [help] [--ai] [--mode tui|ascii] [--size N] [--target N] [--save FILE] [--load FILE]"
+
+// aiMoveInterval is how often the --ai driver makes a move, slow enough for
+// a human to watch it play.
+const aiMoveInterval = 500 * time.Millisecond
 
 /***** Functions *****/
 
+/*
+ Prints the game's help/usage text.
+*/
+func printHelp() {
+	fmt.Println("G048: A Go-implementation of 2048")
+	fmt.Println("\nAbout")
+	fmt.Println("  Author: Schuyler Martin")
+	fmt.Println("  Date:   February 2020")
+	fmt.Println("\n" + USAGE)
+	fmt.Println()
+	fmt.Println("Controls")
+	fmt.Println("  * W/[Up]:         Move up")
+	fmt.Println("  * A/[Left]:       Move left")
+	fmt.Println("  * S/[Down]:       Move right")
+	fmt.Println("  * D/[Right]:      Move down")
+	fmt.Println("  * [Esc]/[Ctrl-C]: Exit game")
+	fmt.Println("\nFlags")
+	fmt.Println("  * --ai:     Let the built-in solver play the game automatically")
+	fmt.Println("  * --mode:   Display driver: tui (default) or ascii, for headless/piped play")
+	fmt.Println("  * --size:   Board width/height, in tiles (default 4)")
+	fmt.Println("  * --target: Tile value that counts as a win (default 2048)")
+	fmt.Println("  * --save:   Persist the game to FILE on exit")
+	fmt.Println("  * --load:   Resume a game previously saved with --save")
+	fmt.Println("\nIn-game")
+	fmt.Println("  * U: Undo the last move")
+	fmt.Println("  * R: Redo a move previously undone")
+	fmt.Println("  * Q: Quit (ascii mode only; tui uses Esc/Ctrl-C)")
+	fmt.Println("  * N: Start a new game (ascii mode only)")
+}
+
+/*
+ loadBoard reads and decodes a board previously saved with --save.
+
+ @param path Path to read the saved game from.
+
+ @return Decoded board, or an error if the file could not be read or
+         parsed.
+*/
+func loadBoard(path string) (*model.Board, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	board := new(model.Board)
+	if err := json.Unmarshal(data, board); err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+/*
+ Drives the board with the built-in solver until the game ends, re-rendering
+ the display after every move it makes.
+
+ @param board   Board the solver plays against.
+ @param display Display to re-render after each solver move.
+*/
+func runAI(board *model.Board, display view.Display) {
+	ticker := time.NewTicker(aiMoveInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if board.IsEndGame() {
+			return
+		}
+		action := ai.BestMove(board)
+		view.ActionHandler(board, action, func() {})
+		display.Redraw()
+	}
+}
+
 /*
  Main entry point of the G048 project.
 */
 func main() {
 	// Handle user input
 	argc := len(os.Args)
-	if argc > 1 {
-		if strings.ToLower(os.Args[1]) == "help" {
-			fmt.Println("G048: A Go-implementation of 2048")
-			fmt.Println("\nAbout")
-			fmt.Println("  Author: Schuyler Martin")
-			fmt.Println("  Date:   February 2020")
-			fmt.Println("\n" + USAGE + "\n")
-			fmt.Println("Controls")
-			fmt.Println("  * W/[Up]:         Move up")
-			fmt.Println("  * A/[Left]:       Move left")
-			fmt.Println("  * S/[Down]:       Move right")
-			fmt.Println("  * D/[Right]:      Move down")
-			fmt.Println("  * [Esc]/[Ctrl-C]: Exit game")
-
-			os.Exit(view.EXIT_SUCCESS)
-		} else {
-			fmt.Fprintf(os.Stderr, "%v\n", USAGE)
-			os.Exit(view.ERROR_USAGE)
-		}
+	if (argc > 1) && (strings.ToLower(os.Args[1]) == "help") {
+		printHelp()
+		os.Exit(view.EXIT_SUCCESS)
+	}
+
+	defaultConfig := model.DefaultBoardConfig()
+	aiFlag := flag.Bool("ai", false, "Let the built-in solver play the game automatically")
+	modeFlag := flag.String("mode", "tui", "Display driver: tui or ascii")
+	sizeFlag := flag.Int("size", defaultConfig.Size, "Board width/height, in tiles")
+	targetFlag := flag.Int("target", int(defaultConfig.WinTarget), "Tile value that counts as a win")
+	saveFlag := flag.String("save", "", "Persist the game to FILE on exit")
+	loadFlag := flag.String("load", "", "Resume a game previously saved with --save")
+	flag.Parse()
+	if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "%v\n", USAGE)
+		os.Exit(view.ERROR_USAGE)
+	}
+
+	var display view.Display
+	switch strings.ToLower(*modeFlag) {
+	case "tui":
+		display = new(view.TextGame)
+	case "ascii":
+		display = new(view.AsciiGame)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown --mode %q (want tui or ascii)\n", *modeFlag)
+		os.Exit(view.ERROR_USAGE)
+	}
+
+	if *sizeFlag < 2 {
+		fmt.Fprintf(os.Stderr, "invalid --size %d (must be at least 2)\n", *sizeFlag)
+		os.Exit(view.ERROR_USAGE)
+	}
+	if *targetFlag <= 0 {
+		fmt.Fprintf(os.Stderr, "invalid --target %d (must be positive)\n", *targetFlag)
+		os.Exit(view.ERROR_USAGE)
 	}
 
+	boardConfig := defaultConfig
+	boardConfig.Size = *sizeFlag
+	boardConfig.WinTarget = model.Tile(*targetFlag)
+
 	// Initialize, run, and exit with the selected mode
-	textGame := new(view.TextGame)
 	playAgain := true
 	for playAgain {
-		textGame.InitGame(model.NewBoard())
-		playAgain = textGame.RenderGame()
+		var board *model.Board
+		if *loadFlag != "" {
+			loaded, err := loadBoard(*loadFlag)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(view.ERROR_USAGE)
+			}
+			board = loaded
+			// Only resume from the save file once; subsequent "play again"
+			// rounds start a fresh game.
+			*loadFlag = ""
+		} else {
+			boardConfig.Seed = time.Now().UnixNano()
+			board = model.NewBoardFromConfig(boardConfig)
+		}
+		display.InitGame(board)
+		display.SetSavePath(*saveFlag)
+		if *aiFlag {
+			go runAI(board, display)
+		}
+		playAgain = display.RenderGame()
 	}
-	textGame.ExitGame()
+	display.ExitGame()
 }
@@ -8,18 +8,31 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 )
 
 /***** Constants *****/
 
 const (
-	// BoardSize is the default size of the board
-	BoardSize = 4
+	// DefaultBoardSize is the width/height used when a board is built with
+	// NewBoard() instead of an explicit BoardConfig.
+	DefaultBoardSize = 4
+	// DefaultFourSpawnChance is the probability a spawned tile is a 4
+	// instead of a 2, used by NewBoard().
+	DefaultFourSpawnChance = 0.25
+	// DefaultHistorySize is how many past moves NewBoard() retains for
+	// Undo/Redo.
+	DefaultHistorySize = 100
 )
 
+// DefaultWinTarget is the tile value that counts as a win when a board is
+// built with NewBoard() instead of an explicit BoardConfig.
+const DefaultWinTarget Tile = 2048
+
 /***** Types *****/
 
 // Tile represents a single tile value on the board.
@@ -28,8 +41,9 @@ type Tile uint32
 // Score represents the user's score
 type Score uint32
 
-// Grid represents tiles on the game board.
-type Grid [BoardSize][BoardSize]Tile
+// Grid represents tiles on the game board. Unlike a fixed-size array, this
+// allows boards of any size (see BoardConfig.Size).
+type Grid [][]Tile
 
 // Coordinate is a convenience structure that stores a (row,col) pairing.
 type Coordinate struct {
@@ -49,54 +63,209 @@ type DrawTile func(pos Coordinate, isEOL bool, tile Tile)
 
 // Board is the primary structure that represents the game's state.
 type Board struct {
+	// Guards every field below against concurrent access, since a board can
+	// be driven by a UI's key-event goroutine and the --ai solver goroutine
+	// at the same time.
+	mu sync.Mutex
 	// Current tile layout
 	grid Grid
 	// Game's current score.
 	score Score
 	// Random number generator
 	random *rand.Rand
+	// Seed the random number generator was built from, retained so it can be
+	// reconstructed at a given position for Undo/Redo and save/load.
+	randSeed int64
+	// Backing source of `random`, tracking its position in the seed's
+	// stream.
+	randSource *countingSource
+	// Tile value that counts as a win; see Board.HasReached.
+	winTarget Tile
+	// Probability a spawned tile is a 4 instead of a 2.
+	fourSpawnChance float64
+	// Undo/redo history.
+	history *history
+}
+
+// BoardConfig configures the size and rules of a new board, so variants
+// (3x3, 5x5, a different win target, ...) don't require a fixed constant.
+type BoardConfig struct {
+	// Size is the board's width and height, in tiles.
+	Size int
+	// WinTarget is the tile value Board.HasReached checks for.
+	WinTarget Tile
+	// FourSpawnChance is the probability a spawned tile is a 4 instead of a
+	// 2.
+	FourSpawnChance float64
+	// Seed seeds the board's RNG. Two boards built from identical configs
+	// (including Seed) play out identically.
+	Seed int64
+	// HistorySize is how many past moves the board retains for Undo/Redo.
+	// A non-positive value disables Undo/Redo.
+	HistorySize int
+}
+
+/*
+ DefaultBoardConfig returns the standard 4x4, win-at-2048 configuration used
+ by NewBoard(), seeded from the current time.
+
+ @return Default board configuration.
+*/
+func DefaultBoardConfig() BoardConfig {
+	return BoardConfig{
+		Size:            DefaultBoardSize,
+		WinTarget:       DefaultWinTarget,
+		FourSpawnChance: DefaultFourSpawnChance,
+		Seed:            time.Now().UnixNano(),
+		HistorySize:     DefaultHistorySize,
+	}
 }
 
-// Helper lambda function that performs one iteration of the move process.
-// This is dependent on the direction.
-type moveBoard func()
+/*
+ newGrid allocates an empty, size x size grid.
+
+ @param size Width/height of the grid, in tiles.
+
+ @return Newly allocated, zero-valued grid.
+*/
+func newGrid(size int) Grid {
+	grid := make(Grid, size)
+	for row := range grid {
+		grid[row] = make([]Tile, size)
+	}
+	return grid
+}
+
+/*
+ cloneGrid deep-copies a grid, since Grid's backing rows are shared slices
+ and a plain assignment would alias them.
+
+ @param g Grid to copy.
+
+ @return Independent copy of g.
+*/
+func cloneGrid(g Grid) Grid {
+	clone := make(Grid, len(g))
+	for row := range g {
+		clone[row] = append([]Tile(nil), g[row]...)
+	}
+	return clone
+}
+
+// MoveResult reports the outcome of a Move* call so callers (the AI,
+// renderers, replay logs, ...) can tell what actually happened without
+// re-diffing the board themselves.
+type MoveResult struct {
+	// Changed is true if at least one tile slid or merged.
+	Changed bool
+	// Points is the score gained from merges made during this move.
+	Points Score
+	// Spawned is the position of the tile generated after this move. Only
+	// meaningful when Changed and HasSpawned are both true.
+	Spawned Coordinate
+	// HasSpawned is true if a new tile was generated after this move.
+	HasSpawned bool
+}
+
+// Helper lambda function that extracts one row/column from the grid, in the
+// order tiles should slide (i.e. index 0 is the edge tiles move towards).
+type extractLine func(idx int) []Tile
+
+// Helper lambda function that writes a merged row/column back into the grid,
+// in the same order it was extracted in.
+type storeLine func(idx int, line []Tile)
+
+// Action identifies one of the four directions a board can be moved in.
+// This only covers board-level moves; UI-level concerns (an illegal key, an
+// exit request) are left to the `view` package.
+type Action uint8
+
+// Enumeration of board moves
+const (
+	ActionLeft Action = iota
+	ActionRight
+	ActionUp
+	ActionDown
+)
 
 /***** Functions *****/
 
 /*
- NewBoard constructs a new board play with.
+ NewBoard constructs a new board to play with, using the default 4x4,
+ win-at-2048 configuration.
 
  @return New board object to start a game with
 */
 func NewBoard() *Board {
+	return NewBoardFromConfig(DefaultBoardConfig())
+}
+
+/*
+ NewBoardWithSeed constructs a new board using the default 4x4, win-at-2048
+ configuration, seeded explicitly instead of from the current time. This
+ gives Replay, golden-file tests, and AI tournaments a reproducible starting
+ point.
+
+ @param seed Seed to build the board's RNG from.
+
+ @return New board object to start a game with
+*/
+func NewBoardWithSeed(seed int64) *Board {
+	cfg := DefaultBoardConfig()
+	cfg.Seed = seed
+	return NewBoardFromConfig(cfg)
+}
+
+/*
+ NewBoardFromConfig constructs a new board using a caller-supplied
+ configuration, allowing variants such as 3x3/5x5/6x6 boards or a different
+ win target.
+
+ @param cfg Configuration to build the board with.
+
+ @return New board object to start a game with
+*/
+func NewBoardFromConfig(cfg BoardConfig) *Board {
 	b := new(Board)
 	b.score = 0
+	b.winTarget = cfg.WinTarget
+	b.fourSpawnChance = cfg.FourSpawnChance
+	b.randSeed = cfg.Seed
 	// Set a new random generator per game. This ensures that we don't
 	// constantly reconstruct the generator for every random value we need.
-	b.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	b.random, b.randSource = newRandom(cfg.Seed)
 	// Initialize the starting board configuration.
+	b.grid = newGrid(cfg.Size)
 	b.initBoard()
+	// The starting layout (post-initBoard) is the earliest state Undo can
+	// unwind back to.
+	b.history = newHistory(cfg.HistorySize)
+	b.history.seed(b.snapshotEntry())
 	return b
 }
 
 /***** Internal Members *****/
 
 /*
- Generates a new random tile and places it on the board
+ Generates a new random tile and places it on the board.
+
+ @return Position of the spawned tile and true, or a zero Coordinate and
+         false if the board has no open position.
 */
-func (b *Board) generateTile() {
+func (b *Board) generateTile() (Coordinate, bool) {
 	// Tiles that are added to the board start at either 2 or 4, with 2 having
 	// a much higher probability to show up.
 	tileValue := Tile(2)
-	if b.random.Intn(4) == 0 {
+	if b.random.Float64() < b.fourSpawnChance {
 		tileValue = 4
 	}
 
 	// Since the board is relatively small, iterate over the entire board and
 	// record all possible positions.
+	size := len(b.grid)
 	var possiblePositions []*Coordinate
-	for row := 0; row < BoardSize; row++ {
-		for col := 0; col < BoardSize; col++ {
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
 			if b.grid[row][col] == 0 {
 				possiblePositions = append(possiblePositions, &Coordinate{row, col})
 			}
@@ -105,10 +274,12 @@ func (b *Board) generateTile() {
 
 	// Only add a new tile if the board has an open position
 	possibleSize := len(possiblePositions)
-	if possibleSize > 0 {
-		pos := possiblePositions[b.random.Intn(possibleSize)]
-		b.grid[pos.Row][pos.Col] = tileValue
+	if possibleSize == 0 {
+		return Coordinate{}, false
 	}
+	pos := possiblePositions[b.random.Intn(possibleSize)]
+	b.grid[pos.Row][pos.Col] = tileValue
+	return *pos, true
 }
 
 /*
@@ -121,45 +292,328 @@ func (b *Board) initBoard() {
 }
 
 /*
- Helper function that centralizes the move logic for all 4 moves, handling
- the accumulation of values and the current score.
+ Compacts a line of tiles towards index 0, preserving order and dropping
+ zero-value gaps.
 
- @param curPos  Current board position being examined
- @param nextPos Next board position in the direction of the move. This is the
-                position that is being accumulated into.
+ @param line Line of tiles to compact, in slide order (index 0 is the edge
+             tiles move towards).
+
+ @return Compacted line, with no zeroes except for trailing padding.
 */
-func (b *Board) calcMove(curPos Coordinate, nextPos Coordinate) {
-	curValue := b.grid[curPos.Row][curPos.Col]
-	nextValue := b.grid[nextPos.Row][nextPos.Col]
-	// If the other value is 0, move the current value in
-	if b.grid[nextPos.Row][nextPos.Col] == 0 {
-		b.grid[nextPos.Row][nextPos.Col] = curValue
-		b.grid[curPos.Row][curPos.Col] = 0
-	} else if curValue == nextValue {
-		// If the values are equal, accumulate
-		b.grid[nextPos.Row][nextPos.Col] *= 2
-		b.grid[curPos.Row][curPos.Col] = 0
-		// Score increments with the value accumulated
-		b.score += Score(nextValue)
+func compactLine(line []Tile) []Tile {
+	compacted := make([]Tile, 0, len(line))
+	for _, value := range line {
+		if value != 0 {
+			compacted = append(compacted, value)
+		}
+	}
+	for len(compacted) < len(line) {
+		compacted = append(compacted, 0)
 	}
+	return compacted
+}
+
+/*
+ Performs a single, non-greedy slide-and-merge of one row/column: tiles are
+ compacted towards index 0, equal neighbors are merged once from index 0
+ onward (so a tile produced by a merge cannot merge again this turn), and
+ the result is compacted a second time to close the gap left by the merge.
+
+ @param line Line of tiles to merge, in slide order (index 0 is the edge
+             tiles move towards).
+
+ @return Resulting line and the points scored by merges made along it.
+*/
+func mergeLine(line []Tile) ([]Tile, Score) {
+	compacted := compactLine(line)
+	merged := make([]Tile, 0, len(line))
+	var points Score
+	for i := 0; i < len(compacted); i++ {
+		value := compacted[i]
+		if value == 0 {
+			break
+		}
+		if (i+1 < len(compacted)) && (value == compacted[i+1]) {
+			value *= 2
+			points += Score(value)
+			i++
+		}
+		merged = append(merged, value)
+	}
+	for len(merged) < len(line) {
+		merged = append(merged, 0)
+	}
+	return merged, points
+}
+
+/*
+ Builds the extract/store lambda pair that walks `grid` in the slide order
+ for `action`. Index 0 of every extracted line is the edge tiles move
+ towards, so the same `mergeLine` logic drives all four directions. `grid`'s
+ rows are shared slices, so the lambdas mutate the caller's grid directly.
+
+ @param grid   Grid the lambdas read from and write to.
+ @param action Direction to build the lambdas for.
+
+ @return Lambdas that extract and store the idx'th row/column for `action`.
+*/
+func linesFor(grid Grid, action Action) (extractLine, storeLine) {
+	size := len(grid)
+	switch action {
+	case ActionLeft:
+		return func(row int) []Tile {
+				return grid[row][:]
+			}, func(row int, line []Tile) {
+				copy(grid[row][:], line)
+			}
+	case ActionRight:
+		return func(row int) []Tile {
+				line := make([]Tile, size)
+				for col := 0; col < size; col++ {
+					line[col] = grid[row][size-1-col]
+				}
+				return line
+			}, func(row int, line []Tile) {
+				for col := 0; col < size; col++ {
+					grid[row][size-1-col] = line[col]
+				}
+			}
+	case ActionUp:
+		return func(col int) []Tile {
+				line := make([]Tile, size)
+				for row := 0; row < size; row++ {
+					line[row] = grid[row][col]
+				}
+				return line
+			}, func(col int, line []Tile) {
+				for row := 0; row < size; row++ {
+					grid[row][col] = line[row]
+				}
+			}
+	default: // ActionDown
+		return func(col int) []Tile {
+				line := make([]Tile, size)
+				for row := 0; row < size; row++ {
+					line[row] = grid[size-1-row][col]
+				}
+				return line
+			}, func(col int, line []Tile) {
+				for row := 0; row < size; row++ {
+					grid[size-1-row][col] = line[row]
+				}
+			}
+	}
+}
+
+/*
+ Slides and merges every line of `grid` for `action`, in place, in a single
+ non-greedy pass per line.
+
+ @param grid   Grid to mutate.
+ @param action Direction to move in.
+
+ @return True if at least one tile slid or merged, and the points scored by
+         merges made along the way.
+*/
+func applyMove(grid Grid, action Action) (bool, Score) {
+	extract, store := linesFor(grid, action)
+	var changed bool
+	var points Score
+	for idx := 0; idx < len(grid); idx++ {
+		before := extract(idx)
+		after, linePoints := mergeLine(before)
+		points += linePoints
+		for i := range after {
+			if after[i] != before[i] {
+				changed = true
+			}
+		}
+		store(idx, after)
+	}
+	return changed, points
 }
 
 /*
  Helper function that de-dupes core move logic from directional iterations.
+ A tile is spawned only if the move actually changed the board, matching the
+ standard 2048 rules.
 
  To quote my alma mater, "Make Moves, Son!"
 
- @param move Helper lambda that iterates over the board in the desired
-             direction.
+ @param action Direction to move in.
+
+ @return Result describing whether the board changed, points scored, and
+         where the spawned tile landed.
 */
-func (b *Board) makeMove(move moveBoard) {
-	// Repeat the accumulation process until all positions move as far as they
-	// can.
-	for i := 1; i < BoardSize; i++ {
-		move()
+func (b *Board) makeMove(action Action) MoveResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	changed, points := applyMove(b.grid, action)
+
+	result := MoveResult{Changed: changed, Points: points}
+	if changed {
+		b.score += points
+		if pos, ok := b.generateTile(); ok {
+			result.Spawned = pos
+			result.HasSpawned = true
+		}
+		b.history.push(b.snapshotEntry())
 	}
-	// Every move generates a tile, if possible
-	b.generateTile()
+	return result
+}
+
+/*
+ snapshotEntry captures the board's current state as a history entry.
+
+ @return History entry for the board's current state.
+*/
+func (b *Board) snapshotEntry() historyEntry {
+	return historyEntry{
+		Grid:         cloneGrid(b.grid),
+		Score:        b.score,
+		RandPosition: b.randSource.calls,
+	}
+}
+
+/*
+ restore replaces the board's live state with a previously captured entry,
+ rebuilding the RNG at the same position it was captured at.
+
+ @param entry Entry to restore.
+*/
+func (b *Board) restore(entry historyEntry) {
+	b.grid = cloneGrid(entry.Grid)
+	b.score = entry.Score
+	b.random, b.randSource = restoreRandom(b.randSeed, entry.RandPosition)
+}
+
+/*
+ Undo reverts the board to the state before its most recent move.
+
+ @return True if a prior state existed to undo to.
+*/
+func (b *Board) Undo() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, ok := b.history.undo()
+	if !ok {
+		return false
+	}
+	b.restore(entry)
+	return true
+}
+
+/*
+ Redo re-applies a move previously undone by Undo.
+
+ @return True if a later state existed to redo to.
+*/
+func (b *Board) Redo() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, ok := b.history.redo()
+	if !ok {
+		return false
+	}
+	b.restore(entry)
+	return true
+}
+
+/*
+ Snapshot returns a read-only, deep copy of the board's current tile layout.
+ Callers (e.g. the `ai` package's search) can freely call `Grid.Simulate` on
+ it without risk of mutating the live board.
+
+ @return Copy of the board's current grid.
+*/
+func (b *Board) Snapshot() Grid {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return cloneGrid(b.grid)
+}
+
+/*
+ Simulate runs a single move against a copy of the grid without spawning a
+ tile, so search code can explore hypothetical moves without touching the
+ board's RNG or live state.
+
+ @param action Direction to move in.
+
+ @return Resulting grid, points that would be scored, and whether the move
+         would actually change the board.
+*/
+func (g Grid) Simulate(action Action) (Grid, Score, bool) {
+	next := cloneGrid(g)
+	changed, points := applyMove(next, action)
+	return next, points, changed
+}
+
+// boardJSON is the on-disk representation of a Board, used by
+// MarshalJSON/UnmarshalJSON to persist and resume a game.
+type boardJSON struct {
+	Grid            Grid           `json:"grid"`
+	Score           Score          `json:"score"`
+	WinTarget       Tile           `json:"winTarget"`
+	FourSpawnChance float64        `json:"fourSpawnChance"`
+	Seed            int64          `json:"seed"`
+	RandPosition    int64          `json:"randPosition"`
+	HistoryCapacity int            `json:"historyCapacity"`
+	HistoryCursor   int            `json:"historyCursor"`
+	HistoryEntries  []historyEntry `json:"historyEntries"`
+}
+
+/*
+ MarshalJSON persists the board's grid, score, RNG seed+position, and
+ history stack, so a game can be resumed later with UnmarshalJSON.
+
+ @return JSON encoding of the board.
+*/
+func (b *Board) MarshalJSON() ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return json.Marshal(boardJSON{
+		Grid:            b.grid,
+		Score:           b.score,
+		WinTarget:       b.winTarget,
+		FourSpawnChance: b.fourSpawnChance,
+		Seed:            b.randSeed,
+		RandPosition:    b.randSource.calls,
+		HistoryCapacity: b.history.capacity,
+		HistoryCursor:   b.history.cursor,
+		HistoryEntries:  b.history.entries,
+	})
+}
+
+/*
+ UnmarshalJSON restores a board previously persisted with MarshalJSON,
+ rebuilding its RNG at the exact position it was saved at.
+
+ @param data JSON encoding of a board, as produced by MarshalJSON.
+
+ @return Error if `data` could not be parsed.
+*/
+func (b *Board) UnmarshalJSON(data []byte) error {
+	var parsed boardJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	b.grid = parsed.Grid
+	b.score = parsed.Score
+	b.winTarget = parsed.WinTarget
+	b.fourSpawnChance = parsed.FourSpawnChance
+	b.randSeed = parsed.Seed
+	b.random, b.randSource = restoreRandom(parsed.Seed, parsed.RandPosition)
+	b.history = &history{
+		capacity: parsed.HistoryCapacity,
+		cursor:   parsed.HistoryCursor,
+		entries:  parsed.HistoryEntries,
+	}
+	return nil
 }
 
 /***** Members *****/
@@ -170,17 +624,78 @@ func (b *Board) makeMove(move moveBoard) {
  @return Score, as a displayable string.
 */
 func (b *Board) GetDisplayScore() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
 	return fmt.Sprintf("Score: %10d", b.score)
 }
 
+/*
+ Size returns the board's width/height, in tiles.
+
+ @return Board size.
+*/
+func (b *Board) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.grid)
+}
+
+/*
+ Seed returns the seed this board's RNG was built from, so a Replay can be
+ built from the exact same starting point.
+
+ @return Board's RNG seed.
+*/
+func (b *Board) Seed() int64 {
+	return b.randSeed
+}
+
+/*
+ WinTarget returns the tile value this board was configured to win at.
+
+ @return Configured win target.
+*/
+func (b *Board) WinTarget() Tile {
+	return b.winTarget
+}
+
+/*
+ HasReached reports whether any tile on the board has reached `target`,
+ independent of whether the game has ended. This lets callers detect a win
+ without waiting for (or in spite of) a subsequent game-over state.
+
+ @param target Tile value to check for.
+
+ @return True if any tile on the board is at least `target`.
+*/
+func (b *Board) HasReached(target Tile) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for row := range b.grid {
+		for col := range b.grid[row] {
+			if b.grid[row][col] >= target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 /*
  RenderBoard iterates over the board, invoking a callback to render a tile
  at a given position.
 */
 func (b *Board) RenderBoard(draw DrawTile) {
-	for row := 0; row < BoardSize; row++ {
-		for col := 0; col < BoardSize; col++ {
-			isEOL := (col + 1) == BoardSize
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	size := len(b.grid)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			isEOL := (col + 1) == size
 			draw(Coordinate{row, col}, isEOL, b.grid[row][col])
 		}
 	}
@@ -192,12 +707,16 @@ func (b *Board) RenderBoard(draw DrawTile) {
  @return True if the game ended. False otherwise.
 */
 func (b *Board) IsEndGame() bool {
-	boundSize := BoardSize - 1
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	size := len(b.grid)
+	boundSize := size - 1
 	// To end the game:
 	//   1) The board must be filled.
 	//   2) There are no 2 adjacent tiles with the same value.
-	for row := 0; row < BoardSize; row++ {
-		for col := 0; col < BoardSize; col++ {
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
 			value := b.grid[row][col]
 			// Board is not filled
 			if value == 0 {
@@ -223,52 +742,40 @@ func (b *Board) IsEndGame() bool {
 
 /*
  MoveLeft moves tiles to the left
+
+ @return Result describing whether the board changed, points scored, and
+         where the spawned tile landed.
 */
-func (b *Board) MoveLeft() {
-	b.makeMove(func() {
-		for row := 0; row < BoardSize; row++ {
-			for col := 1; col < BoardSize; col++ {
-				b.calcMove(Coordinate{row, col}, Coordinate{row, col - 1})
-			}
-		}
-	})
+func (b *Board) MoveLeft() MoveResult {
+	return b.makeMove(ActionLeft)
 }
 
 /*
  MoveRight moves tiles to the right
+
+ @return Result describing whether the board changed, points scored, and
+         where the spawned tile landed.
 */
-func (b *Board) MoveRight() {
-	b.makeMove(func() {
-		for row := 0; row < BoardSize; row++ {
-			for col := BoardSize - 2; col >= 0; col-- {
-				b.calcMove(Coordinate{row, col}, Coordinate{row, col + 1})
-			}
-		}
-	})
+func (b *Board) MoveRight() MoveResult {
+	return b.makeMove(ActionRight)
 }
 
 /*
  MoveUp moves tiles up
+
+ @return Result describing whether the board changed, points scored, and
+         where the spawned tile landed.
 */
-func (b *Board) MoveUp() {
-	b.makeMove(func() {
-		for row := 1; row < BoardSize; row++ {
-			for col := 0; col < BoardSize; col++ {
-				b.calcMove(Coordinate{row, col}, Coordinate{row - 1, col})
-			}
-		}
-	})
+func (b *Board) MoveUp() MoveResult {
+	return b.makeMove(ActionUp)
 }
 
 /*
  MoveDown moves tiles down
+
+ @return Result describing whether the board changed, points scored, and
+         where the spawned tile landed.
 */
-func (b *Board) MoveDown() {
-	b.makeMove(func() {
-		for row := BoardSize - 2; row >= 0; row-- {
-			for col := 0; col < BoardSize; col++ {
-				b.calcMove(Coordinate{row, col}, Coordinate{row + 1, col})
-			}
-		}
-	})
+func (b *Board) MoveDown() MoveResult {
+	return b.makeMove(ActionDown)
 }
@@ -0,0 +1,171 @@
+/*
+ * File:        board_test.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: Table tests for the non-greedy slide+merge engine.
+ */
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeLine(t *testing.T) {
+	cases := []struct {
+		name       string
+		line       []Tile
+		wantLine   []Tile
+		wantPoints Score
+	}{
+		{
+			name:       "all empty",
+			line:       []Tile{0, 0, 0, 0},
+			wantLine:   []Tile{0, 0, 0, 0},
+			wantPoints: 0,
+		},
+		{
+			name:       "already compacted, no merges",
+			line:       []Tile{2, 4, 0, 0},
+			wantLine:   []Tile{2, 4, 0, 0},
+			wantPoints: 0,
+		},
+		{
+			name:       "gap is closed without merging",
+			line:       []Tile{2, 0, 4, 0},
+			wantLine:   []Tile{2, 4, 0, 0},
+			wantPoints: 0,
+		},
+		{
+			name:       "single merge",
+			line:       []Tile{2, 2, 0, 0},
+			wantLine:   []Tile{4, 0, 0, 0},
+			wantPoints: 4,
+		},
+		{
+			name:       "a merged tile cannot merge again this turn",
+			line:       []Tile{2, 2, 2, 2},
+			wantLine:   []Tile{4, 4, 0, 0},
+			wantPoints: 8,
+		},
+		{
+			name:       "three equal tiles merge only the leading pair",
+			line:       []Tile{2, 2, 2, 0},
+			wantLine:   []Tile{4, 2, 0, 0},
+			wantPoints: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotLine, gotPoints := mergeLine(tc.line)
+			if !reflect.DeepEqual(gotLine, tc.wantLine) {
+				t.Errorf("mergeLine(%v) line = %v, want %v", tc.line, gotLine, tc.wantLine)
+			}
+			if gotPoints != tc.wantPoints {
+				t.Errorf("mergeLine(%v) points = %v, want %v", tc.line, gotPoints, tc.wantPoints)
+			}
+		})
+	}
+}
+
+func TestApplyMove(t *testing.T) {
+	cases := []struct {
+		name        string
+		grid        Grid
+		action      Action
+		wantGrid    Grid
+		wantChanged bool
+		wantPoints  Score
+	}{
+		{
+			name: "left, non-greedy merge per the Rosetta example",
+			grid: Grid{
+				{2, 2, 2, 2},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			action: ActionLeft,
+			wantGrid: Grid{
+				{4, 4, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			wantChanged: true,
+			wantPoints:  8,
+		},
+		{
+			name: "right",
+			grid: Grid{
+				{2, 2, 2, 2},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			action: ActionRight,
+			wantGrid: Grid{
+				{0, 0, 4, 4},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			wantChanged: true,
+			wantPoints:  8,
+		},
+		{
+			name: "up",
+			grid: Grid{
+				{2, 0, 0, 0},
+				{2, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			action: ActionUp,
+			wantGrid: Grid{
+				{4, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			wantChanged: true,
+			wantPoints:  4,
+		},
+		{
+			name: "down, already settled is a no-op",
+			grid: Grid{
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{2, 0, 0, 0},
+			},
+			action: ActionDown,
+			wantGrid: Grid{
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{2, 0, 0, 0},
+			},
+			wantChanged: false,
+			wantPoints:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			grid := cloneGrid(tc.grid)
+			gotChanged, gotPoints := applyMove(grid, tc.action)
+			if gotChanged != tc.wantChanged {
+				t.Errorf("applyMove changed = %v, want %v", gotChanged, tc.wantChanged)
+			}
+			if gotPoints != tc.wantPoints {
+				t.Errorf("applyMove points = %v, want %v", gotPoints, tc.wantPoints)
+			}
+			if !reflect.DeepEqual(grid, tc.wantGrid) {
+				t.Errorf("applyMove grid = %v, want %v", grid, tc.wantGrid)
+			}
+		})
+	}
+}
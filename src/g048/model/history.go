@@ -0,0 +1,114 @@
+/*
+ * File:        history.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: Bounded undo/redo history for a board.
+ */
+package model
+
+/***** Types *****/
+
+// historyEntry captures everything needed to restore a board to a point in
+// time: the tile layout, the score, and the RNG's position so future tile
+// spawns still come from the same deterministic stream. Fields are exported
+// so the entry round-trips through Board's JSON encoding untouched.
+type historyEntry struct {
+	Grid         Grid
+	Score        Score
+	RandPosition int64
+}
+
+// history is a bounded ring buffer of past board states, with a cursor
+// tracking the current position for Undo/Redo.
+type history struct {
+	entries  []historyEntry
+	capacity int
+	cursor   int
+}
+
+/***** Functions *****/
+
+/*
+ newHistory builds an empty history bounded to `capacity` undoable moves.
+ A non-positive capacity disables history entirely (Undo/Redo always fail).
+
+ @param capacity Maximum number of moves that can be undone.
+
+ @return New, empty history.
+*/
+func newHistory(capacity int) *history {
+	return &history{capacity: capacity}
+}
+
+/***** Members *****/
+
+/*
+ seed records the board's starting state. Called once, before any moves are
+ made, so Undo can always unwind back to the initial layout.
+
+ @param entry Starting state to record.
+*/
+func (h *history) seed(entry historyEntry) {
+	if h.capacity <= 0 {
+		return
+	}
+	h.entries = []historyEntry{entry}
+	h.cursor = 0
+}
+
+/*
+ push records the state after a successful move. Any redo-able future left
+ over from a prior Undo is discarded, and the oldest entry is evicted once
+ the ring buffer's capacity is exceeded.
+
+ @param entry State to record.
+*/
+func (h *history) push(entry historyEntry) {
+	if h.capacity <= 0 {
+		return
+	}
+	h.entries = append(h.entries[:h.cursor+1], entry)
+	if len(h.entries) > h.capacity+1 {
+		h.entries = h.entries[1:]
+	}
+	h.cursor = len(h.entries) - 1
+}
+
+// canUndo reports whether there is an earlier state to undo to.
+func (h *history) canUndo() bool {
+	return h.cursor > 0
+}
+
+// canRedo reports whether there is a later state to redo to.
+func (h *history) canRedo() bool {
+	return h.cursor < len(h.entries)-1
+}
+
+/*
+ undo moves the cursor back one state.
+
+ @return Prior state and true, or a zero entry and false if there is
+         nothing to undo.
+*/
+func (h *history) undo() (historyEntry, bool) {
+	if !h.canUndo() {
+		return historyEntry{}, false
+	}
+	h.cursor--
+	return h.entries[h.cursor], true
+}
+
+/*
+ redo moves the cursor forward one state.
+
+ @return Later state and true, or a zero entry and false if there is
+         nothing to redo.
+*/
+func (h *history) redo() (historyEntry, bool) {
+	if !h.canRedo() {
+		return historyEntry{}, false
+	}
+	h.cursor++
+	return h.entries[h.cursor], true
+}
@@ -0,0 +1,110 @@
+/*
+ * File:        history_test.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: Tests for the bounded undo/redo ring buffer.
+ */
+package model
+
+import "testing"
+
+// entryAt is a small helper that builds a distinguishable history entry for
+// a given "move number", so tests can assert on which one came back.
+func entryAt(n int64) historyEntry {
+	return historyEntry{Score: Score(n), RandPosition: n}
+}
+
+func TestHistoryUndoRedo(t *testing.T) {
+	h := newHistory(100)
+	h.seed(entryAt(0))
+
+	if h.canUndo() {
+		t.Fatalf("canUndo() = true right after seed, want false")
+	}
+
+	h.push(entryAt(1))
+	h.push(entryAt(2))
+
+	if !h.canUndo() {
+		t.Fatalf("canUndo() = false after two pushes, want true")
+	}
+	if h.canRedo() {
+		t.Fatalf("canRedo() = true with nothing undone, want false")
+	}
+
+	entry, ok := h.undo()
+	if !ok || entry.Score != 1 {
+		t.Fatalf("undo() = (%v, %v), want (entry with Score 1, true)", entry, ok)
+	}
+
+	if !h.canRedo() {
+		t.Fatalf("canRedo() = false after an undo, want true")
+	}
+
+	entry, ok = h.redo()
+	if !ok || entry.Score != 2 {
+		t.Fatalf("redo() = (%v, %v), want (entry with Score 2, true)", entry, ok)
+	}
+	if h.canRedo() {
+		t.Fatalf("canRedo() = true after redoing back to the tip, want false")
+	}
+}
+
+func TestHistoryPushDiscardsRedoFuture(t *testing.T) {
+	h := newHistory(100)
+	h.seed(entryAt(0))
+	h.push(entryAt(1))
+	h.push(entryAt(2))
+
+	if _, ok := h.undo(); !ok {
+		t.Fatalf("undo() ok = false, want true")
+	}
+
+	// A fresh move after an undo should discard the redo-able future.
+	h.push(entryAt(3))
+	if h.canRedo() {
+		t.Fatalf("canRedo() = true after pushing past an undo, want false")
+	}
+
+	entry, ok := h.undo()
+	if !ok || entry.Score != 1 {
+		t.Fatalf("undo() = (%v, %v), want (entry with Score 1, true)", entry, ok)
+	}
+}
+
+func TestHistoryEvictsOldestBeyondCapacity(t *testing.T) {
+	h := newHistory(2)
+	h.seed(entryAt(0))
+	h.push(entryAt(1))
+	h.push(entryAt(2))
+	// Capacity 2 means at most 3 entries total (initial + 2 moves); this
+	// third push should evict the seeded initial state.
+	h.push(entryAt(3))
+
+	if len(h.entries) != 3 {
+		t.Fatalf("len(entries) = %v, want 3", len(h.entries))
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, ok := h.undo(); !ok {
+			t.Fatalf("undo() ok = false at step %d, want true", i)
+		}
+	}
+	if h.canUndo() {
+		t.Fatalf("canUndo() = true after undoing past the evicted entry, want false")
+	}
+}
+
+func TestHistoryDisabledWithNonPositiveCapacity(t *testing.T) {
+	h := newHistory(0)
+	h.seed(entryAt(0))
+	h.push(entryAt(1))
+
+	if h.canUndo() || h.canRedo() {
+		t.Fatalf("canUndo/canRedo = true with a non-positive capacity, want false")
+	}
+	if _, ok := h.undo(); ok {
+		t.Fatalf("undo() ok = true with a non-positive capacity, want false")
+	}
+}
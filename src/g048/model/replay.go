@@ -0,0 +1,60 @@
+/*
+ * File:        replay.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: Records a board's seed and move sequence so a game can be
+ *              played back deterministically, for golden-file tests, AI
+ *              benchmark tournaments across seeds, and shareable "here's the
+ *              game where I hit 4096" artifacts.
+ */
+package model
+
+/***** Types *****/
+
+// Replay is a board's initial seed plus the sequence of moves made against
+// it. Replaying those moves against a board built from the same seed (e.g.
+// via NewBoardWithSeed) reproduces the exact same game, spawns included.
+type Replay struct {
+	// Seed is the seed the board was built from.
+	Seed int64
+	// Actions is the sequence of moves made, in order.
+	Actions []Action
+}
+
+/***** Functions *****/
+
+/*
+ NewReplay starts recording a replay for a board built from `seed`.
+
+ @param seed Seed the board was (or will be) built from.
+
+ @return New, empty replay.
+*/
+func NewReplay(seed int64) *Replay {
+	return &Replay{Seed: seed}
+}
+
+/***** Members *****/
+
+/*
+ Record appends a move to the replay.
+
+ @param action Move to append.
+*/
+func (r *Replay) Record(action Action) {
+	r.Actions = append(r.Actions, action)
+}
+
+/*
+ Play re-applies every recorded move to `board`, in order. `board` must have
+ been built from the replay's Seed (e.g. via NewBoardWithSeed(r.Seed)) for
+ the result to match the original game exactly.
+
+ @param board Board to replay the moves onto.
+*/
+func (r *Replay) Play(board *Board) {
+	for _, action := range r.Actions {
+		board.makeMove(action)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * File:        rng.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: A seed-position-tracking random source, so a board's RNG
+ *              state can be captured for undo/redo and save/load and later
+ *              reconstructed exactly.
+ */
+package model
+
+import (
+	"math/rand"
+)
+
+/***** Types *****/
+
+// countingSource wraps a rand.Source and counts how many raw draws have been
+// made from it. Since every method on *rand.Rand (Intn, Float64, ...) is
+// ultimately implemented in terms of its source's Int63(), replaying that
+// many Int63() calls against a freshly-seeded source reproduces the exact
+// same position in the stream, regardless of which higher-level methods
+// were actually called to get there.
+type countingSource struct {
+	rand.Source
+	// calls is the number of Int63() draws made from this source.
+	calls int64
+}
+
+/***** Members *****/
+
+// Int63 satisfies rand.Source, delegating to the wrapped source and
+// counting the draw.
+func (c *countingSource) Int63() int64 {
+	c.calls++
+	return c.Source.Int63()
+}
+
+// Seed satisfies rand.Source, delegating to the wrapped source and
+// resetting the draw count back to the start of the stream.
+func (c *countingSource) Seed(seed int64) {
+	c.Source.Seed(seed)
+	c.calls = 0
+}
+
+/***** Functions *****/
+
+/*
+ newRandom builds a freshly-seeded, position-tracking random generator.
+
+ @param seed Seed to build the generator from.
+
+ @return Generator ready to use, and the counting source backing it (so its
+         position can be read back out later).
+*/
+func newRandom(seed int64) (*rand.Rand, *countingSource) {
+	source := &countingSource{Source: rand.NewSource(seed)}
+	return rand.New(source), source
+}
+
+/*
+ restoreRandom rebuilds a random generator at a specific position in its
+ stream, by re-seeding and discarding draws up to that position.
+
+ @param seed     Original seed the generator was built from.
+ @param position Number of draws that had already been made.
+
+ @return Generator at the same position it was captured at, and the
+         counting source backing it.
+*/
+func restoreRandom(seed int64, position int64) (*rand.Rand, *countingSource) {
+	source := &countingSource{Source: rand.NewSource(seed)}
+	for i := int64(0); i < position; i++ {
+		source.Source.Int63()
+	}
+	source.calls = position
+	return rand.New(source), source
+}
@@ -0,0 +1,169 @@
+/*
+ * File:        asciiGame.go
+ *
+ * Author:      Schuyler Martin <schuylermartin45@gmail.com>
+ *
+ * Description: A headless gameplay mode that reads single-character
+ *              commands from stdin and renders in plain ASCII, so the game
+ *              can be driven over a pipe (CI, scripted AI harnesses) without
+ *              ever touching the terminal in raw mode.
+ */
+package view
+
+import (
+	"../model"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+/***** Types *****/
+
+// AsciiGame renders G048 to stdout using plain ASCII, reading single-
+// character commands from stdin.
+type AsciiGame struct {
+	board  *model.Board
+	reader *bufio.Reader
+	// savePath, if set, is where the game is persisted to on quit; see
+	// SetSavePath.
+	savePath string
+}
+
+/***** Internal Members *****/
+
+/*
+ Draws the board as a grid of box-drawn cells, preceded by the score.
+*/
+func (a *AsciiGame) drawBoard() {
+	fmt.Println(a.board.GetDisplayScore())
+
+	const cellWidth = 6
+	size := a.board.Size()
+	border := "+" + strings.Repeat(strings.Repeat("-", cellWidth)+"+", size)
+
+	fmt.Println(border)
+	row := make([]string, size)
+	a.board.RenderBoard(func(pos model.Coordinate, isEOL bool, tile model.Tile) {
+		cell := ""
+		if tile != 0 {
+			cell = fmt.Sprintf("%d", tile)
+		}
+		row[pos.Col] = fmt.Sprintf("%*s", cellWidth, cell)
+		if isEOL {
+			fmt.Println("|" + strings.Join(row, "|") + "|")
+			fmt.Println(border)
+		}
+	})
+
+	// Once the configured target is reached, say so below the board.
+	if a.board.HasReached(a.board.WinTarget()) {
+		fmt.Printf("You reached %d! Keep playing or exit.\n", a.board.WinTarget())
+	}
+}
+
+/*
+ Persists the game to `savePath`, if one was set via SetSavePath. Errors are
+ reported to stderr rather than blocking the quit they're called from.
+*/
+func (a *AsciiGame) saveOnExit() {
+	if a.savePath == "" {
+		return
+	}
+	data, err := json.Marshal(a.board)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	if err := ioutil.WriteFile(a.savePath, data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
+}
+
+/*
+ promptPlayAgain asks, over stdin/stdout, whether to start a new game after
+ one has ended.
+
+ @return True if the user answered yes.
+*/
+func (a *AsciiGame) promptPlayAgain() bool {
+	fmt.Print("Play again? [y/N]: ")
+	r, _, err := a.reader.ReadRune()
+	if err != nil {
+		return false
+	}
+	return (r == 'y') || (r == 'Y')
+}
+
+/***** Methods *****/
+
+// InitGame initializes the game.
+func (a *AsciiGame) InitGame(b *model.Board) {
+	a.board = b
+	if a.reader == nil {
+		a.reader = bufio.NewReader(os.Stdin)
+	}
+}
+
+// SetSavePath configures where the game is persisted to when the user quits
+// (`q`). An empty path (the default) disables saving.
+func (a *AsciiGame) SetSavePath(path string) {
+	a.savePath = path
+}
+
+// Redraw forces an immediate re-render of the board. Used by drivers that
+// move the board outside of RenderGame's own input loop, such as the --ai
+// solver.
+func (a *AsciiGame) Redraw() {
+	a.drawBoard()
+}
+
+// RenderGame runs the primary gameplay loop, reading one command at a time
+// from stdin: W/A/S/D to move, U/R to undo/redo, N to start a new game, and
+// Q to quit.
+func (a *AsciiGame) RenderGame() bool {
+	a.drawBoard()
+	quit := false
+	newGame := false
+	for !quit && !newGame && !a.board.IsEndGame() {
+		r, _, err := a.reader.ReadRune()
+		if err != nil {
+			return false
+		}
+		if (r == 'n') || (r == 'N') {
+			newGame = true
+			continue
+		}
+
+		action := RuneToAction(r)
+		if action == ActionIllegal {
+			continue
+		}
+		ActionHandler(a.board, action, func() {
+			a.saveOnExit()
+			quit = true
+		})
+		if !quit {
+			a.drawBoard()
+		}
+	}
+
+	if quit {
+		return false
+	}
+	if newGame {
+		return true
+	}
+	// The game also ends this way (IsEndGame, not a 'q' quit), so save here
+	// too.
+	a.saveOnExit()
+	fmt.Println("Game over!")
+	return a.promptPlayAgain()
+}
+
+// ExitGame is a callback triggered when the game terminates. AsciiGame has
+// no terminal/screen state to tear down.
+func (a *AsciiGame) ExitGame() {
+}
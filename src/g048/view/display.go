@@ -33,6 +33,8 @@ const (
 	ActionUp      Action = 3
 	ActionDown    Action = 4
 	ActionExit    Action = 5
+	ActionUndo    Action = 6
+	ActionRedo    Action = 7
 )
 
 // ExitFunc is a callback triggered on `ActionExit`. This breaks the game loop
@@ -47,10 +49,47 @@ type Display interface {
 	RenderGame() bool
 	// Callback for when the game terminates.
 	ExitGame()
+	// Forces an immediate re-render, for drivers (e.g. --ai) that move the
+	// board outside of the driver's own input loop.
+	Redraw()
+	// Configures where the game is persisted to on exit. An empty path
+	// disables saving.
+	SetSavePath(path string)
 }
 
 /***** Functions *****/
 
+/*
+ RuneToAction maps a single input character to the Action it represents.
+ Shared by every Display driver so key bindings (W/A/S/D to move, U/R to
+ undo/redo, Q to quit) stay consistent across `--mode=tui` and
+ `--mode=ascii`.
+
+ @param r Input character to map.
+
+ @return Equivalent action, or ActionIllegal if r isn't bound to one.
+*/
+func RuneToAction(r rune) Action {
+	switch r {
+	case 'w', 'W':
+		return ActionUp
+	case 'a', 'A':
+		return ActionLeft
+	case 's', 'S':
+		return ActionDown
+	case 'd', 'D':
+		return ActionRight
+	case 'u', 'U':
+		return ActionUndo
+	case 'r', 'R':
+		return ActionRedo
+	case 'q', 'Q':
+		return ActionExit
+	default:
+		return ActionIllegal
+	}
+}
+
 /*
  Action handler. Given an action, performs a board operation.
 
@@ -58,19 +97,22 @@ type Display interface {
  @param action Action to interpret
  @param onExit	Function to call on exit
 */
-func ActionHandler(board *model.Board, action Action, onExit ExitFunc) {
+func ActionHandler(board *model.Board, action Action, onExit ExitFunc) model.MoveResult {
 	switch action {
-	case ActionIllegal:
-		return
 	case ActionLeft:
-		board.MoveLeft()
+		return board.MoveLeft()
 	case ActionRight:
-		board.MoveRight()
+		return board.MoveRight()
 	case ActionUp:
-		board.MoveUp()
+		return board.MoveUp()
 	case ActionDown:
-		board.MoveDown()
+		return board.MoveDown()
+	case ActionUndo:
+		return model.MoveResult{Changed: board.Undo()}
+	case ActionRedo:
+		return model.MoveResult{Changed: board.Redo()}
 	case ActionExit:
 		onExit()
 	}
+	return model.MoveResult{}
 }
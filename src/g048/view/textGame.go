@@ -9,8 +9,10 @@ package view
 
 import (
 	"../model"
+	"encoding/json"
 	"fmt"
 	"github.com/gdamore/tcell"
+	"io/ioutil"
 	"os"
 	// Ticking away, the moments that make up the dull day...
 	"time"
@@ -22,6 +24,9 @@ import (
 type TextGame struct {
 	board  *model.Board
 	screen tcell.Screen
+	// savePath, if set, is where the game is persisted to on exit; see
+	// SetSavePath.
+	savePath string
 }
 
 /***** Internal Functions *****/
@@ -97,11 +102,13 @@ func (t *TextGame) drawBoard() {
 	t.screen.Fill(' ', tcell.StyleDefault.Background(tcell.ColorBlack))
 	// Screen constants
 	const (
-		blankStr    = "        " // No border
-		blockWidth  = len(blankStr)
-		boardWidth  = blockWidth * model.BoardSize
-		boardHeight = model.BoardSize
+		blankStr   = "        " // No border
+		blockWidth = len(blankStr)
 	)
+	// Screen dimensions depend on the board's configured size.
+	boardSize := t.board.Size()
+	boardWidth := blockWidth * boardSize
+	boardHeight := boardSize
 	xScreen, yScreen := t.screen.Size()
 	// Screen variables
 	var (
@@ -116,6 +123,13 @@ func (t *TextGame) drawBoard() {
 	// Draw the score above the board
 	t.drawStr(xScore, yScore, scoreStr, whiteText)
 
+	// Once the configured target is reached, say so above the score.
+	if t.board.HasReached(t.board.WinTarget()) {
+		winStr := fmt.Sprintf("You reached %d! Keep playing or exit.", t.board.WinTarget())
+		xWin := (xScreen / 2) - (len(winStr) / 2)
+		t.drawStr(xWin, yScore-1, winStr, whiteText)
+	}
+
 	// Draw the board
 	y := yBoard
 	t.board.RenderBoard(func(pos model.Coordinate, isEOL bool, tile model.Tile) {
@@ -167,18 +181,10 @@ func (t *TextGame) initEventListener() {
 		case *tcell.EventKey:
 			var action Action = ActionIllegal
 			switch eventType.Key() {
-			// ASCII keys have to be handled separately
+			// ASCII keys are mapped the same way every Display driver maps
+			// them; see RuneToAction.
 			case tcell.KeyRune:
-				switch eventType.Rune() {
-				case 'w':
-					action = ActionUp
-				case 'a':
-					action = ActionLeft
-				case 'd':
-					action = ActionRight
-				case 's':
-					action = ActionDown
-				}
+				action = RuneToAction(eventType.Rune())
 			case tcell.KeyUp:
 				action = ActionUp
 			case tcell.KeyLeft:
@@ -195,6 +201,7 @@ func (t *TextGame) initEventListener() {
 			}
 			if action != ActionIllegal {
 				ActionHandler(t.board, action, func() {
+					t.saveOnExit()
 					t.screen.Fini()
 					os.Exit(EXIT_SUCCESS)
 					return
@@ -232,6 +239,37 @@ func (t *TextGame) InitGame(b *model.Board) {
 	}
 }
 
+// SetSavePath configures where the game is persisted to when the user exits
+// (`Esc`/`Ctrl-C`). An empty path (the default) disables saving.
+func (t *TextGame) SetSavePath(path string) {
+	t.savePath = path
+}
+
+/*
+ Persists the game to `savePath`, if one was set via SetSavePath. Errors are
+ reported to stderr rather than blocking the exit they're called from.
+*/
+func (t *TextGame) saveOnExit() {
+	if t.savePath == "" {
+		return
+	}
+	data, err := json.Marshal(t.board)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	if err := ioutil.WriteFile(t.savePath, data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
+}
+
+// Redraw forces an immediate re-render of the board. This is used by drivers
+// that move the board outside of the interactive key-event listener, such as
+// the `--ai` solver loop, which otherwise has no reason to trigger a redraw.
+func (t *TextGame) Redraw() {
+	t.drawBoard()
+}
+
 // RenderGame runs the primary gameplay loop.
 func (t *TextGame) RenderGame() bool {
 	// Draw the initial board. Subsequent renders will come on a user's action.
@@ -242,6 +280,8 @@ func (t *TextGame) RenderGame() bool {
 	for !t.board.IsEndGame() {
 		time.Sleep(200 * time.Millisecond)
 	}
+	// The game also ends this way (no Esc/Ctrl-C), so save here too.
+	t.saveOnExit()
 	return true
 }
 